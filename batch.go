@@ -0,0 +1,218 @@
+package shopsavvy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// defaultChunkSize matches the API's documented limit on ids per request.
+const defaultChunkSize = 50
+
+// BatchOptions controls how a *All method splits a large identifier list
+// into request-sized chunks.
+type BatchOptions struct {
+	// ChunkSize is the number of identifiers sent per request. Defaults to
+	// 50 if unset.
+	ChunkSize int
+	// Concurrency is the number of chunks sent in parallel. Defaults to 1
+	// (sequential) if unset.
+	Concurrency int
+	// StopOnError, when true, cancels in-flight and not-yet-started chunks
+	// as soon as one fails and discards partial results. When false, all
+	// chunks still run and partial results are returned alongside a
+	// BatchError.
+	StopOnError bool
+}
+
+func (o BatchOptions) withDefaults() BatchOptions {
+	if o.ChunkSize <= 0 {
+		o.ChunkSize = defaultChunkSize
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 1
+	}
+	return o
+}
+
+// BatchError reports that one or more chunks of a batch call failed. Err is
+// the first error encountered; FailedIDs lists every identifier belonging
+// to a failed chunk so callers can retry just that slice.
+type BatchError struct {
+	FailedIDs []string
+	Err       error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("shopsavvy: batch request failed for %d id(s): %v", len(e.FailedIDs), e.Err)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+func chunkStrings(ids []string, size int) [][]string {
+	var chunks [][]string
+	for len(ids) > 0 {
+		if size >= len(ids) {
+			chunks = append(chunks, ids)
+			break
+		}
+		chunks = append(chunks, ids[:size])
+		ids = ids[size:]
+	}
+	return chunks
+}
+
+// idempotencyKeyOf returns the Idempotency-Key reqOpts would set on a
+// request, if any, by applying them to a scratch request and reading the
+// header back off it.
+func idempotencyKeyOf(reqOpts []RequestOption) string {
+	r := resty.New().R()
+	applyRequestOptions(r, reqOpts)
+	return r.Header.Get(idempotencyHeader)
+}
+
+// perChunkRequestOptions derives request options for the given chunk: if
+// reqOpts carries an Idempotency-Key, it's suffixed with the chunk index so
+// each chunk gets its own key instead of every chunk replaying the same one
+// (which would make an idempotent server dedup all but the first chunk).
+func perChunkRequestOptions(reqOpts []RequestOption, chunkIndex int) []RequestOption {
+	baseKey := idempotencyKeyOf(reqOpts)
+	if baseKey == "" {
+		return reqOpts
+	}
+	return append(append([]RequestOption{}, reqOpts...), WithIdempotencyKey(fmt.Sprintf("%s-chunk-%d", baseKey, chunkIndex)))
+}
+
+// runBatches splits ids into chunks per opts, runs fetch over them with
+// bounded concurrency, and merges the results back in original input order.
+func runBatches[T any](ctx context.Context, ids []string, opts BatchOptions, fetch func(ctx context.Context, chunk []string, chunkIndex int) (*APIResponse[[]T], error)) ([]T, int, error) {
+	opts = opts.withDefaults()
+	chunks := chunkStrings(ids, opts.ChunkSize)
+
+	results := make([][]T, len(chunks))
+	credits := make([]int, len(chunks))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	var failedIDs []string
+
+	for i, chunk := range chunks {
+		select {
+		case <-ctx.Done():
+			mu.Lock()
+			failedIDs = append(failedIDs, chunk...)
+			if firstErr == nil {
+				firstErr = ctx.Err()
+			}
+			mu.Unlock()
+		default:
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int, chunk []string) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				resp, err := fetch(ctx, chunk, i)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					failedIDs = append(failedIDs, chunk...)
+					if firstErr == nil {
+						firstErr = err
+					}
+					if opts.StopOnError {
+						cancel()
+					}
+					return
+				}
+				results[i] = resp.Data
+				credits[i] = resp.CreditsUsed()
+			}(i, chunk)
+		}
+	}
+	wg.Wait()
+
+	merged := make([]T, 0, len(ids))
+	totalCredits := 0
+	for i := range results {
+		merged = append(merged, results[i]...)
+		totalCredits += credits[i]
+	}
+
+	if firstErr != nil {
+		batchErr := &BatchError{FailedIDs: failedIDs, Err: firstErr}
+		if opts.StopOnError {
+			return nil, totalCredits, batchErr
+		}
+		return merged, totalCredits, batchErr
+	}
+
+	return merged, totalCredits, nil
+}
+
+// GetProductDetailsAll looks up details for a large list of identifiers,
+// automatically splitting them into request-sized chunks and running up to
+// opts.Concurrency of them in parallel.
+func (c *Client) GetProductDetailsAll(ctx context.Context, ids []string, opts BatchOptions, format ...string) (*APIResponse[[]ProductDetails], error) {
+	merged, credits, err := runBatches(ctx, ids, opts, func(ctx context.Context, chunk []string, chunkIndex int) (*APIResponse[[]ProductDetails], error) {
+		return c.GetProductDetailsBatch(ctx, chunk, format...)
+	})
+	if merged == nil && err != nil {
+		return nil, err
+	}
+	return &APIResponse[[]ProductDetails]{Success: err == nil, Data: merged, Meta: &APIMeta{CreditsUsed: credits}}, err
+}
+
+// GetCurrentOffersAll gets current offers for a large list of identifiers,
+// automatically splitting them into request-sized chunks and running up to
+// opts.Concurrency of them in parallel.
+func (c *Client) GetCurrentOffersAll(ctx context.Context, ids []string, retailer string, opts BatchOptions, format ...string) (*APIResponse[[]ProductWithOffers], error) {
+	merged, credits, err := runBatches(ctx, ids, opts, func(ctx context.Context, chunk []string, chunkIndex int) (*APIResponse[[]ProductWithOffers], error) {
+		return c.GetCurrentOffersBatch(ctx, chunk, retailer, format...)
+	})
+	if merged == nil && err != nil {
+		return nil, err
+	}
+	return &APIResponse[[]ProductWithOffers]{Success: err == nil, Data: merged, Meta: &APIMeta{CreditsUsed: credits}}, err
+}
+
+// ScheduleProductMonitoringAll schedules monitoring for a large list of
+// identifiers, automatically splitting them into request-sized chunks and
+// running up to opts.Concurrency of them in parallel. Pass WithIdempotencyKey
+// in reqOpts to opt each chunk into safe retries; the key is suffixed per
+// chunk so distinct chunk bodies don't collide on the same dedup key.
+func (c *Client) ScheduleProductMonitoringAll(ctx context.Context, ids []string, frequency string, retailer []string, opts BatchOptions, reqOpts ...RequestOption) (*APIResponse[[]ScheduleBatchResponse], error) {
+	merged, credits, err := runBatches(ctx, ids, opts, func(ctx context.Context, chunk []string, chunkIndex int) (*APIResponse[[]ScheduleBatchResponse], error) {
+		return c.ScheduleProductMonitoringBatch(ctx, chunk, frequency, retailer, perChunkRequestOptions(reqOpts, chunkIndex)...)
+	})
+	if merged == nil && err != nil {
+		return nil, err
+	}
+	return &APIResponse[[]ScheduleBatchResponse]{Success: err == nil, Data: merged, Meta: &APIMeta{CreditsUsed: credits}}, err
+}
+
+// RemoveProductsFromScheduleAll removes a large list of identifiers from
+// the monitoring schedule, automatically splitting them into request-sized
+// chunks and running up to opts.Concurrency of them in parallel. Pass
+// WithIdempotencyKey in reqOpts to opt each chunk into safe retries; the key
+// is suffixed per chunk so distinct chunk bodies don't collide on the same
+// dedup key.
+func (c *Client) RemoveProductsFromScheduleAll(ctx context.Context, ids []string, opts BatchOptions, reqOpts ...RequestOption) (*APIResponse[[]RemoveBatchResponse], error) {
+	merged, credits, err := runBatches(ctx, ids, opts, func(ctx context.Context, chunk []string, chunkIndex int) (*APIResponse[[]RemoveBatchResponse], error) {
+		return c.RemoveProductsFromSchedule(ctx, chunk, perChunkRequestOptions(reqOpts, chunkIndex)...)
+	})
+	if merged == nil && err != nil {
+		return nil, err
+	}
+	return &APIResponse[[]RemoveBatchResponse]{Success: err == nil, Data: merged, Meta: &APIMeta{CreditsUsed: credits}}, err
+}
@@ -12,7 +12,7 @@
 //	}
 //	defer client.Close()
 //
-//	product, err := client.GetProductDetails("012345678901")
+//	product, err := client.GetProductDetails(context.Background(), "012345678901")
 //	if err != nil {
 //		log.Fatal(err)
 //	}
@@ -20,6 +20,7 @@
 package shopsavvy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -30,12 +31,22 @@ import (
 )
 
 // Version is the current SDK version
-const Version = "1.0.1"
+//
+// v2.0.0 is a breaking release: every Client method now takes a
+// context.Context as its first argument so callers can enforce
+// per-request deadlines and cancellation.
+const Version = "2.0.0"
 
 // Client represents the ShopSavvy Data API client
 type Client struct {
 	config *Config
 	client *resty.Client
+
+	// rateLimitRemaining and rateLimitKnown back the WithRateLimitAdapter
+	// throttle; they're updated from OnAfterResponse and read from
+	// OnBeforeRequest, so they're plain atomics rather than client state.
+	rateLimitRemaining int32
+	rateLimitKnown     int32
 }
 
 // Config holds the configuration for the ShopSavvy API client
@@ -43,6 +54,11 @@ type Config struct {
 	APIKey  string
 	BaseURL string
 	Timeout time.Duration
+
+	MaxRetries         int
+	RetryBaseDelay     time.Duration
+	RateLimitAdapter   bool
+	RateLimitThreshold int
 }
 
 // NewClient creates a new ShopSavvy Data API client with the given API key
@@ -76,18 +92,37 @@ func NewClient(apiKey string, options ...Option) (*Client, error) {
 		SetHeader("Authorization", "Bearer "+config.APIKey).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("User-Agent", "ShopSavvy-Go-SDK/"+Version).
-		SetError(&APIErrorResponse{}).
-		OnAfterResponse(func(c *resty.Client, resp *resty.Response) error {
-			if resp.IsError() {
-				return handleErrorResponse(resp)
-			}
-			return nil
-		})
-
-	return &Client{
+		SetError(&APIErrorResponse{})
+
+	sdkClient := &Client{
 		config: config,
 		client: client,
-	}, nil
+	}
+
+	if config.MaxRetries > 0 {
+		client.SetRetryCount(config.MaxRetries).
+			SetRetryWaitTime(config.RetryBaseDelay).
+			SetRetryMaxWaitTime(config.RetryBaseDelay * time.Duration(config.MaxRetries) * 4).
+			SetRetryAfter(sdkClient.retryAfter).
+			AddRetryCondition(isRetryable)
+	}
+
+	if config.RateLimitAdapter {
+		client.OnBeforeRequest(sdkClient.throttleForRateLimit)
+		// Registered before the error-handling hook below so the adapter
+		// still observes rate_limit_remaining on 4xx/5xx responses
+		// (notably 429s) instead of only on success.
+		client.OnAfterResponse(sdkClient.recordRateLimitRemaining)
+	}
+
+	client.OnAfterResponse(func(c *resty.Client, resp *resty.Response) error {
+		if resp.IsError() {
+			return handleErrorResponse(resp)
+		}
+		return nil
+	})
+
+	return sdkClient, nil
 }
 
 // Option is a functional option for configuring the client
@@ -107,26 +142,50 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithRetry enables automatic retry with exponential backoff for idempotent
+// requests (GETs, or any request opted in via WithIdempotencyKey). maxAttempts
+// is the number of retries after the initial attempt; baseDelay seeds the
+// backoff curve.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(c *Config) {
+		c.MaxRetries = maxAttempts
+		c.RetryBaseDelay = baseDelay
+	}
+}
+
+// WithRateLimitAdapter enables adaptive throttling: once the most recently
+// observed APIMeta.RateLimitRemaining drops below threshold, subsequent
+// requests are delayed proportionally to the remaining deficit before being
+// sent, spreading load to avoid tripping the rate limiter.
+func WithRateLimitAdapter(threshold int) Option {
+	return func(c *Config) {
+		c.RateLimitAdapter = true
+		c.RateLimitThreshold = threshold
+	}
+}
+
 // Close closes the HTTP client and releases resources
 func (c *Client) Close() {
 	// resty client doesn't need explicit closing, but we provide this for consistency
 }
 
-// SearchProducts searches for products by keyword
-func (c *Client) SearchProducts(query string, limit, offset int) (*ProductSearchResult, error) {
-	params := map[string]string{
-		"q": query,
-	}
-	if limit > 0 {
-		params["limit"] = fmt.Sprintf("%d", limit)
-	}
-	if offset > 0 {
-		params["offset"] = fmt.Sprintf("%d", offset)
+// Search searches for products by keyword, returning a SearchIterator that
+// transparently pages through the full result set. Iteration doesn't begin
+// until Next is called, so cancellation is controlled by the ctx passed to
+// Next rather than by a context here.
+func (c *Client) Search(query SearchQuery) (*SearchIterator, error) {
+	if err := query.validate(); err != nil {
+		return nil, err
 	}
+	return &SearchIterator{client: c, query: query}, nil
+}
 
+// searchPage fetches a single page of SearchQuery results.
+func (c *Client) searchPage(ctx context.Context, query SearchQuery) (*ProductSearchResult, error) {
 	var response ProductSearchResult
 	_, err := c.client.R().
-		SetQueryParams(params).
+		SetContext(ctx).
+		SetQueryParams(query.buildParams()).
 		SetResult(&response).
 		Get("/products/search")
 
@@ -138,7 +197,7 @@ func (c *Client) SearchProducts(query string, limit, offset int) (*ProductSearch
 }
 
 // GetProductDetails looks up product details by identifier
-func (c *Client) GetProductDetails(identifier string, format ...string) (*APIResponse[[]ProductDetails], error) {
+func (c *Client) GetProductDetails(ctx context.Context, identifier string, format ...string) (*APIResponse[[]ProductDetails], error) {
 	params := map[string]string{
 		"ids": identifier,
 	}
@@ -148,6 +207,7 @@ func (c *Client) GetProductDetails(identifier string, format ...string) (*APIRes
 
 	var response APIResponse[[]ProductDetails]
 	_, err := c.client.R().
+		SetContext(ctx).
 		SetQueryParams(params).
 		SetResult(&response).
 		Get("/products")
@@ -160,7 +220,7 @@ func (c *Client) GetProductDetails(identifier string, format ...string) (*APIRes
 }
 
 // GetProductDetailsBatch looks up details for multiple products
-func (c *Client) GetProductDetailsBatch(identifiers []string, format ...string) (*APIResponse[[]ProductDetails], error) {
+func (c *Client) GetProductDetailsBatch(ctx context.Context, identifiers []string, format ...string) (*APIResponse[[]ProductDetails], error) {
 	params := map[string]string{
 		"ids": strings.Join(identifiers, ","),
 	}
@@ -170,6 +230,7 @@ func (c *Client) GetProductDetailsBatch(identifiers []string, format ...string)
 
 	var response APIResponse[[]ProductDetails]
 	_, err := c.client.R().
+		SetContext(ctx).
 		SetQueryParams(params).
 		SetResult(&response).
 		Get("/products")
@@ -182,7 +243,7 @@ func (c *Client) GetProductDetailsBatch(identifiers []string, format ...string)
 }
 
 // GetCurrentOffers gets current offers for a product
-func (c *Client) GetCurrentOffers(identifier string, retailer string, format ...string) (*APIResponse[[]ProductWithOffers], error) {
+func (c *Client) GetCurrentOffers(ctx context.Context, identifier string, retailer string, format ...string) (*APIResponse[[]ProductWithOffers], error) {
 	params := map[string]string{
 		"ids": identifier,
 	}
@@ -195,6 +256,7 @@ func (c *Client) GetCurrentOffers(identifier string, retailer string, format ...
 
 	var response APIResponse[[]ProductWithOffers]
 	_, err := c.client.R().
+		SetContext(ctx).
 		SetQueryParams(params).
 		SetResult(&response).
 		Get("/products/offers")
@@ -207,7 +269,7 @@ func (c *Client) GetCurrentOffers(identifier string, retailer string, format ...
 }
 
 // GetCurrentOffersBatch gets current offers for multiple products
-func (c *Client) GetCurrentOffersBatch(identifiers []string, retailer string, format ...string) (*APIResponse[[]ProductWithOffers], error) {
+func (c *Client) GetCurrentOffersBatch(ctx context.Context, identifiers []string, retailer string, format ...string) (*APIResponse[[]ProductWithOffers], error) {
 	params := map[string]string{
 		"ids": strings.Join(identifiers, ","),
 	}
@@ -220,6 +282,7 @@ func (c *Client) GetCurrentOffersBatch(identifiers []string, retailer string, fo
 
 	var response APIResponse[[]ProductWithOffers]
 	_, err := c.client.R().
+		SetContext(ctx).
 		SetQueryParams(params).
 		SetResult(&response).
 		Get("/products/offers")
@@ -231,23 +294,16 @@ func (c *Client) GetCurrentOffersBatch(identifiers []string, retailer string, fo
 	return &response, nil
 }
 
-// GetPriceHistory gets price history for a product
-func (c *Client) GetPriceHistory(identifier, startDate, endDate string, retailer string, format ...string) (*APIResponse[[]OfferWithHistory], error) {
-	params := map[string]string{
-		"ids":        identifier,
-		"start_date": startDate,
-		"end_date":   endDate,
-	}
-	if retailer != "" {
-		params["retailer"] = retailer
-	}
-	if len(format) > 0 && format[0] != "" {
-		params["format"] = format[0]
+// GetPriceHistory gets price history for a product matching query.
+func (c *Client) GetPriceHistory(ctx context.Context, query PriceHistoryQuery) (*APIResponse[[]OfferWithHistory], error) {
+	if err := query.validate(); err != nil {
+		return nil, err
 	}
 
 	var response APIResponse[[]OfferWithHistory]
 	_, err := c.client.R().
-		SetQueryParams(params).
+		SetContext(ctx).
+		SetQueryParams(query.buildParams()).
 		SetResult(&response).
 		Get("/products/offers/history")
 
@@ -258,8 +314,10 @@ func (c *Client) GetPriceHistory(identifier, startDate, endDate string, retailer
 	return &response, nil
 }
 
-// ScheduleProductMonitoring schedules product monitoring
-func (c *Client) ScheduleProductMonitoring(identifier, frequency string, retailer ...string) (*APIResponse[ScheduleResponse], error) {
+// ScheduleProductMonitoring schedules product monitoring. POST requests are
+// not retried automatically; pass WithIdempotencyKey to opt a call into safe
+// retries.
+func (c *Client) ScheduleProductMonitoring(ctx context.Context, identifier, frequency string, retailer []string, opts ...RequestOption) (*APIResponse[ScheduleResponse], error) {
 	body := map[string]interface{}{
 		"identifier": identifier,
 		"frequency":  frequency,
@@ -268,9 +326,13 @@ func (c *Client) ScheduleProductMonitoring(identifier, frequency string, retaile
 		body["retailer"] = retailer[0]
 	}
 
+	req := c.client.R().
+		SetContext(ctx).
+		SetBody(body)
+	applyRequestOptions(req, opts)
+
 	var response APIResponse[ScheduleResponse]
-	_, err := c.client.R().
-		SetBody(body).
+	_, err := req.
 		SetResult(&response).
 		Post("/products/schedule")
 
@@ -281,8 +343,10 @@ func (c *Client) ScheduleProductMonitoring(identifier, frequency string, retaile
 	return &response, nil
 }
 
-// ScheduleProductMonitoringBatch schedules monitoring for multiple products
-func (c *Client) ScheduleProductMonitoringBatch(identifiers []string, frequency string, retailer ...string) (*APIResponse[[]ScheduleBatchResponse], error) {
+// ScheduleProductMonitoringBatch schedules monitoring for multiple products.
+// POST requests are not retried automatically; pass WithIdempotencyKey to opt
+// a call into safe retries.
+func (c *Client) ScheduleProductMonitoringBatch(ctx context.Context, identifiers []string, frequency string, retailer []string, opts ...RequestOption) (*APIResponse[[]ScheduleBatchResponse], error) {
 	body := map[string]interface{}{
 		"identifiers": strings.Join(identifiers, ","),
 		"frequency":   frequency,
@@ -291,9 +355,13 @@ func (c *Client) ScheduleProductMonitoringBatch(identifiers []string, frequency
 		body["retailer"] = retailer[0]
 	}
 
+	req := c.client.R().
+		SetContext(ctx).
+		SetBody(body)
+	applyRequestOptions(req, opts)
+
 	var response APIResponse[[]ScheduleBatchResponse]
-	_, err := c.client.R().
-		SetBody(body).
+	_, err := req.
 		SetResult(&response).
 		Post("/products/schedule")
 
@@ -304,10 +372,22 @@ func (c *Client) ScheduleProductMonitoringBatch(identifiers []string, frequency
 	return &response, nil
 }
 
-// GetScheduledProducts gets all scheduled products
-func (c *Client) GetScheduledProducts() (*APIResponse[[]ScheduledProduct], error) {
+// GetScheduledProducts gets a single page of scheduled products. For large
+// monitoring sets, prefer GetScheduledProductsIter to page through all of
+// them without manual offset bookkeeping.
+func (c *Client) GetScheduledProducts(ctx context.Context, limit, offset int) (*APIResponse[[]ScheduledProduct], error) {
+	params := map[string]string{}
+	if limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", limit)
+	}
+	if offset > 0 {
+		params["offset"] = fmt.Sprintf("%d", offset)
+	}
+
 	var response APIResponse[[]ScheduledProduct]
 	_, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParams(params).
 		SetResult(&response).
 		Get("/products/scheduled")
 
@@ -318,15 +398,29 @@ func (c *Client) GetScheduledProducts() (*APIResponse[[]ScheduledProduct], error
 	return &response, nil
 }
 
-// RemoveProductFromSchedule removes a product from monitoring schedule
-func (c *Client) RemoveProductFromSchedule(identifier string) (*APIResponse[RemoveResponse], error) {
+// GetScheduledProductsIter returns a ScheduledProductIterator that
+// transparently pages through all scheduled products. Iteration doesn't
+// begin until Next is called, so cancellation is controlled by the ctx
+// passed to Next rather than by a context here.
+func (c *Client) GetScheduledProductsIter() *ScheduledProductIterator {
+	return &ScheduledProductIterator{client: c}
+}
+
+// RemoveProductFromSchedule removes a product from monitoring schedule. DELETE
+// requests are not retried automatically; pass WithIdempotencyKey to opt a
+// call into safe retries.
+func (c *Client) RemoveProductFromSchedule(ctx context.Context, identifier string, opts ...RequestOption) (*APIResponse[RemoveResponse], error) {
 	body := map[string]interface{}{
 		"identifier": identifier,
 	}
 
+	req := c.client.R().
+		SetContext(ctx).
+		SetBody(body)
+	applyRequestOptions(req, opts)
+
 	var response APIResponse[RemoveResponse]
-	_, err := c.client.R().
-		SetBody(body).
+	_, err := req.
 		SetResult(&response).
 		Delete("/products/schedule")
 
@@ -337,17 +431,46 @@ func (c *Client) RemoveProductFromSchedule(identifier string) (*APIResponse[Remo
 	return &response, nil
 }
 
-// RemoveProductsFromSchedule removes multiple products from monitoring schedule
-func (c *Client) RemoveProductsFromSchedule(identifiers []string) (*APIResponse[[]RemoveBatchResponse], error) {
+// RemoveProductsFromSchedule removes multiple products from monitoring
+// schedule. DELETE requests are not retried automatically; pass
+// WithIdempotencyKey to opt a call into safe retries.
+func (c *Client) RemoveProductsFromSchedule(ctx context.Context, identifiers []string, opts ...RequestOption) (*APIResponse[[]RemoveBatchResponse], error) {
 	body := map[string]interface{}{
 		"identifiers": strings.Join(identifiers, ","),
 	}
 
+	req := c.client.R().
+		SetContext(ctx).
+		SetBody(body)
+	applyRequestOptions(req, opts)
+
 	var response APIResponse[[]RemoveBatchResponse]
+	_, err := req.
+		SetResult(&response).
+		Delete("/products/schedule")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// RegisterWebhook registers a webhook endpoint to receive callbacks for the
+// given event types (see the webhooks sub-package for consuming them, e.g.
+// webhooks.EventPriceChange).
+func (c *Client) RegisterWebhook(ctx context.Context, url string, events ...string) (*APIResponse[WebhookRegistration], error) {
+	body := map[string]interface{}{
+		"url":    url,
+		"events": events,
+	}
+
+	var response APIResponse[WebhookRegistration]
 	_, err := c.client.R().
+		SetContext(ctx).
 		SetBody(body).
 		SetResult(&response).
-		Delete("/products/schedule")
+		Post("/webhooks")
 
 	if err != nil {
 		return nil, err
@@ -357,9 +480,10 @@ func (c *Client) RemoveProductsFromSchedule(identifiers []string) (*APIResponse[
 }
 
 // GetUsage gets API usage information
-func (c *Client) GetUsage() (*APIResponse[UsageInfo], error) {
+func (c *Client) GetUsage(ctx context.Context) (*APIResponse[UsageInfo], error) {
 	var response APIResponse[UsageInfo]
 	_, err := c.client.R().
+		SetContext(ctx).
 		SetResult(&response).
 		Get("/usage")
 
@@ -375,8 +499,10 @@ func handleErrorResponse(resp *resty.Response) error {
 	statusCode := resp.StatusCode()
 
 	var errorMsg string
+	var fields map[string]string
 	if errorResp, ok := resp.Error().(*APIErrorResponse); ok && errorResp.Error != "" {
 		errorMsg = errorResp.Error
+		fields = errorResp.Fields
 	} else {
 		errorMsg = fmt.Sprintf("HTTP %d: %s", statusCode, http.StatusText(statusCode))
 	}
@@ -396,12 +522,19 @@ func handleErrorResponse(resp *resty.Response) error {
 		return &ValidationError{
 			Message:    "Request validation failed. Check your parameters.",
 			StatusCode: statusCode,
+			Fields:     fields,
 		}
 	case 429:
-		return &RateLimitError{
+		retryAfter, _ := parseRetryAfter(resp.Header().Get("Retry-After"))
+		rateLimitErr := &RateLimitError{
 			Message:    "Rate limit exceeded. Please slow down your requests.",
 			StatusCode: statusCode,
+			RetryAfter: retryAfter,
+		}
+		if retryAfter > 0 {
+			rateLimitErr.ResetAt = time.Now().Add(retryAfter)
 		}
+		return rateLimitErr
 	default:
 		return &APIError{
 			Message:    errorMsg,
@@ -1,6 +1,53 @@
 package shopsavvy
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Sentinel errors for use with errors.Is. Every typed error below unwraps
+// to one of these, so callers can write errors.Is(err, shopsavvy.ErrAuth)
+// instead of type-switching.
+var (
+	ErrAPI        = errors.New("shopsavvy: api error")
+	ErrAuth       = errors.New("shopsavvy: authentication error")
+	ErrNotFound   = errors.New("shopsavvy: not found")
+	ErrValidation = errors.New("shopsavvy: validation error")
+	ErrRateLimit  = errors.New("shopsavvy: rate limit exceeded")
+	ErrNetwork    = errors.New("shopsavvy: network error")
+	ErrTimeout    = errors.New("shopsavvy: timeout")
+)
+
+// IsRateLimit reports whether err is (or wraps) a RateLimitError.
+func IsRateLimit(err error) bool {
+	return errors.Is(err, ErrRateLimit)
+}
+
+// IsAuth reports whether err is (or wraps) an AuthenticationError.
+func IsAuth(err error) bool {
+	return errors.Is(err, ErrAuth)
+}
+
+// IsNotFound reports whether err is (or wraps) a NotFoundError.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsValidation reports whether err is (or wraps) a ValidationError.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// AsAPIError reports whether err is (or wraps) an *APIError, returning it
+// if so.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
 
 // APIError represents a general API error
 type APIError struct {
@@ -12,6 +59,10 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Message)
 }
 
+func (e *APIError) Unwrap() error {
+	return ErrAPI
+}
+
 // AuthenticationError represents an authentication failure
 type AuthenticationError struct {
 	Message    string
@@ -22,6 +73,10 @@ func (e *AuthenticationError) Error() string {
 	return fmt.Sprintf("Authentication error (%d): %s", e.StatusCode, e.Message)
 }
 
+func (e *AuthenticationError) Unwrap() error {
+	return ErrAuth
+}
+
 // NotFoundError represents a resource not found error
 type NotFoundError struct {
 	Message    string
@@ -32,26 +87,47 @@ func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("Not found error (%d): %s", e.StatusCode, e.Message)
 }
 
+func (e *NotFoundError) Unwrap() error {
+	return ErrNotFound
+}
+
 // ValidationError represents a request validation error
 type ValidationError struct {
 	Message    string
 	StatusCode int
+	// Fields maps field name to a human-readable validation message, when
+	// the API returns structured validation details.
+	Fields map[string]string
 }
 
 func (e *ValidationError) Error() string {
 	return fmt.Sprintf("Validation error (%d): %s", e.StatusCode, e.Message)
 }
 
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
 // RateLimitError represents a rate limit exceeded error
 type RateLimitError struct {
 	Message    string
 	StatusCode int
+	// RetryAfter is how long to wait before retrying, parsed from the
+	// Retry-After header.
+	RetryAfter time.Duration
+	// ResetAt is when the rate limit window resets, derived from
+	// RetryAfter.
+	ResetAt time.Time
 }
 
 func (e *RateLimitError) Error() string {
 	return fmt.Sprintf("Rate limit error (%d): %s", e.StatusCode, e.Message)
 }
 
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimit
+}
+
 // NetworkError represents a network connectivity error
 type NetworkError struct {
 	Message string
@@ -61,6 +137,10 @@ func (e *NetworkError) Error() string {
 	return fmt.Sprintf("Network error: %s", e.Message)
 }
 
+func (e *NetworkError) Unwrap() error {
+	return ErrNetwork
+}
+
 // TimeoutError represents a request timeout error
 type TimeoutError struct {
 	Message string
@@ -68,4 +148,8 @@ type TimeoutError struct {
 
 func (e *TimeoutError) Error() string {
 	return fmt.Sprintf("Timeout error: %s", e.Message)
-}
\ No newline at end of file
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return ErrTimeout
+}
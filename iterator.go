@@ -0,0 +1,214 @@
+package shopsavvy
+
+import "context"
+
+// defaultIterPageSize is the page size ScheduledProductIterator requests,
+// since GetScheduledProductsIter takes no page size. SearchIterator instead
+// uses SearchQuery.Limit.
+const defaultIterPageSize = 100
+
+// SearchIterator pages through the results of a Search call, fetching
+// subsequent pages on demand as Next is called.
+type SearchIterator struct {
+	client *Client
+	query  SearchQuery
+
+	page         *ProductSearchResult
+	idx          int
+	creditsSpent int
+	err          error
+	exhausted    bool
+}
+
+// Next advances the iterator and reports whether a product is available via
+// Product. It fetches additional pages transparently and stops early if ctx
+// is cancelled.
+func (it *SearchIterator) Next(ctx context.Context) bool {
+	if it.err != nil || it.exhausted {
+		return false
+	}
+
+	select {
+	case <-ctx.Done():
+		it.err = ctx.Err()
+		return false
+	default:
+	}
+
+	if it.page == nil {
+		if !it.fetchPage(ctx) {
+			return false
+		}
+	}
+
+	it.idx++
+	if it.idx < len(it.page.Data) {
+		return true
+	}
+
+	if !it.hasMore() {
+		it.exhausted = true
+		return false
+	}
+
+	it.query.Offset += it.page.Pagination.Returned
+	if !it.fetchPage(ctx) {
+		return false
+	}
+	if len(it.page.Data) == 0 {
+		it.exhausted = true
+		return false
+	}
+	it.idx = 0
+	return true
+}
+
+// Product returns the current product. Only valid after a call to Next that
+// returned true.
+func (it *SearchIterator) Product() ProductDetails {
+	if it.page == nil || it.idx < 0 || it.idx >= len(it.page.Data) {
+		return ProductDetails{}
+	}
+	return it.page.Data[it.idx]
+}
+
+// Err returns the first error encountered, if any.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+// Page returns the pagination metadata for the most recently fetched page.
+func (it *SearchIterator) Page() *PaginationInfo {
+	if it.page == nil {
+		return nil
+	}
+	return it.page.Pagination
+}
+
+// CreditsSpent returns the total credits used across all pages fetched so
+// far.
+func (it *SearchIterator) CreditsSpent() int {
+	return it.creditsSpent
+}
+
+func (it *SearchIterator) hasMore() bool {
+	if it.page == nil || it.page.Pagination == nil {
+		return false
+	}
+	return it.query.Offset+it.page.Pagination.Returned < it.page.Pagination.Total
+}
+
+func (it *SearchIterator) fetchPage(ctx context.Context) bool {
+	page, err := it.client.searchPage(ctx, it.query)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.creditsSpent += page.CreditsUsed()
+	it.page = page
+	it.idx = -1
+	return true
+}
+
+// ScheduledProductIterator pages through GetScheduledProducts, fetching
+// subsequent pages on demand as Next is called.
+type ScheduledProductIterator struct {
+	client *Client
+	offset int
+
+	page         *APIResponse[[]ScheduledProduct]
+	idx          int
+	creditsSpent int
+	err          error
+	exhausted    bool
+}
+
+// Next advances the iterator and reports whether a product is available via
+// Product. It fetches additional pages transparently and stops early if ctx
+// is cancelled.
+func (it *ScheduledProductIterator) Next(ctx context.Context) bool {
+	if it.err != nil || it.exhausted {
+		return false
+	}
+
+	select {
+	case <-ctx.Done():
+		it.err = ctx.Err()
+		return false
+	default:
+	}
+
+	if it.page == nil {
+		if !it.fetchPage(ctx) {
+			return false
+		}
+	}
+
+	it.idx++
+	if it.idx < len(it.page.Data) {
+		return true
+	}
+
+	if !it.hasMore() {
+		it.exhausted = true
+		return false
+	}
+
+	it.offset += it.page.Pagination.Returned
+	if !it.fetchPage(ctx) {
+		return false
+	}
+	if len(it.page.Data) == 0 {
+		it.exhausted = true
+		return false
+	}
+	it.idx = 0
+	return true
+}
+
+// Product returns the current scheduled product. Only valid after a call to
+// Next that returned true.
+func (it *ScheduledProductIterator) Product() ScheduledProduct {
+	if it.page == nil || it.idx < 0 || it.idx >= len(it.page.Data) {
+		return ScheduledProduct{}
+	}
+	return it.page.Data[it.idx]
+}
+
+// Err returns the first error encountered, if any.
+func (it *ScheduledProductIterator) Err() error {
+	return it.err
+}
+
+// Page returns the pagination metadata for the most recently fetched page.
+func (it *ScheduledProductIterator) Page() *PaginationInfo {
+	if it.page == nil {
+		return nil
+	}
+	return it.page.Pagination
+}
+
+// CreditsSpent returns the total credits used across all pages fetched so
+// far.
+func (it *ScheduledProductIterator) CreditsSpent() int {
+	return it.creditsSpent
+}
+
+func (it *ScheduledProductIterator) hasMore() bool {
+	if it.page == nil || it.page.Pagination == nil {
+		return false
+	}
+	return it.offset+it.page.Pagination.Returned < it.page.Pagination.Total
+}
+
+func (it *ScheduledProductIterator) fetchPage(ctx context.Context) bool {
+	page, err := it.client.GetScheduledProducts(ctx, defaultIterPageSize, it.offset)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.creditsSpent += page.CreditsUsed()
+	it.page = page
+	it.idx = -1
+	return true
+}
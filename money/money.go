@@ -0,0 +1,219 @@
+// Package money provides a fixed-point Amount type for representing prices,
+// avoiding the silent rounding and broken equality comparisons that come
+// with using float64 directly for money.
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// defaultExponent is the number of decimal digits assumed for a currency
+// with no entry in currencyExponents (matches the vast majority of ISO
+// 4217 currencies, e.g. USD/EUR/GBP cents).
+const defaultExponent = 2
+
+// currencyExponents overrides defaultExponent for currencies whose minor
+// unit isn't hundredths, so minor-unit math isn't silently off by a factor
+// of 10/100 for them (e.g. JPY has no minor unit at all).
+var currencyExponents = map[string]int{
+	// Zero-decimal currencies.
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0,
+	// Three-decimal currencies.
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+func exponentFor(currency string) int {
+	if exp, ok := currencyExponents[currency]; ok {
+		return exp
+	}
+	return defaultExponent
+}
+
+func scale(exponent int) float64 {
+	return math.Pow10(exponent)
+}
+
+// Amount represents a monetary value as integer minor units (e.g. cents)
+// alongside an optional ISO 4217 currency code, so arithmetic and equality
+// comparisons are exact. The minor-unit scale (exponent) is derived from
+// the currency: most currencies use 2 decimal places, but e.g. JPY uses 0
+// and BHD uses 3.
+type Amount struct {
+	minorUnits int64
+	exponent   int
+	currency   string
+
+	// decoded holds the full-precision major-unit value as parsed by
+	// UnmarshalJSON, before it's rounded into minorUnits at the (possibly
+	// wrong, since currency isn't known yet) default exponent. SetCurrency
+	// rescales from decoded rather than from Float64() so a later currency
+	// with a different exponent doesn't compound the original rounding.
+	decoded    float64
+	hasDecoded bool
+}
+
+// New creates an Amount from a major-unit value (e.g. dollars) and an
+// optional currency code.
+func New(value float64, currency string) Amount {
+	exponent := exponentFor(currency)
+	return Amount{minorUnits: int64(math.Round(value * scale(exponent))), exponent: exponent, currency: currency}
+}
+
+// FromMinorUnits creates an Amount directly from minor units (e.g. cents).
+// The unit scale is derived from currency.
+func FromMinorUnits(units int64, currency string) Amount {
+	return Amount{minorUnits: units, exponent: exponentFor(currency), currency: currency}
+}
+
+// Currency returns the ISO 4217 currency code, if known.
+func (a Amount) Currency() string {
+	return a.currency
+}
+
+// MinorUnits returns the exact integer minor-unit value, scaled per the
+// currency's exponent (e.g. cents for USD, whole units for JPY).
+func (a Amount) MinorUnits() int64 {
+	return a.minorUnits
+}
+
+// Float64 returns the major-unit value as a float64. Prefer MinorUnits or
+// the arithmetic helpers for anything that needs exactness.
+func (a Amount) Float64() float64 {
+	return float64(a.minorUnits) / scale(a.exponent)
+}
+
+// SetCurrency assigns a currency to an Amount that was decoded without one
+// (see UnmarshalJSON), rescaling minorUnits if the currency's exponent
+// differs from the default two-decimal assumption used at decode time. If
+// the Amount came from UnmarshalJSON, rescaling uses the original
+// full-precision parsed value rather than Float64(), so a currency with
+// more decimal places than the default (e.g. BHD's three) doesn't inherit
+// rounding error from the two-decimal guess made at decode time.
+func (a *Amount) SetCurrency(currency string) {
+	exponent := exponentFor(currency)
+	if exponent != a.exponent {
+		value := a.Float64()
+		if a.hasDecoded {
+			value = a.decoded
+		}
+		a.exponent = exponent
+		a.minorUnits = int64(math.Round(value * scale(exponent)))
+	}
+	a.currency = currency
+}
+
+// Sub returns a - other. The result carries a's currency. Returns an error
+// if both amounts have a known, differing currency.
+func (a Amount) Sub(other Amount) (Amount, error) {
+	if err := requireSameCurrency(a, other); err != nil {
+		return Amount{}, err
+	}
+	return Amount{minorUnits: a.minorUnits - other.minorUnits, exponent: a.exponent, currency: a.currency}, nil
+}
+
+// Add returns a + other. The result carries a's currency. Returns an error
+// if both amounts have a known, differing currency.
+func (a Amount) Add(other Amount) (Amount, error) {
+	if err := requireSameCurrency(a, other); err != nil {
+		return Amount{}, err
+	}
+	return Amount{minorUnits: a.minorUnits + other.minorUnits, exponent: a.exponent, currency: a.currency}, nil
+}
+
+// PercentChange returns the percentage change from other to a, e.g. -15.0
+// for a price that dropped 15%. Returns 0 if other is zero, and an error if
+// both amounts have a known, differing currency.
+func (a Amount) PercentChange(other Amount) (float64, error) {
+	if err := requireSameCurrency(a, other); err != nil {
+		return 0, err
+	}
+	if other.minorUnits == 0 {
+		return 0, nil
+	}
+	return (float64(a.minorUnits) - float64(other.minorUnits)) / float64(other.minorUnits) * 100, nil
+}
+
+// requireSameCurrency guards arithmetic between amounts: comparing a known
+// currency against a different known currency is always a bug (e.g. mixing
+// a USD offer with a EUR one), even though the minor-unit math would
+// otherwise run without complaint.
+func requireSameCurrency(a, b Amount) error {
+	if a.currency != "" && b.currency != "" && a.currency != b.currency {
+		return fmt.Errorf("money: mismatched currencies %q and %q", a.currency, b.currency)
+	}
+	return nil
+}
+
+// Format renders the amount for display, e.g. "$12.34". locale currently
+// only affects the decimal separator ("," for locales that use it); the
+// currency symbol, when known, is prefixed using common ISO 4217 mappings.
+// The number of decimal places matches the currency's exponent (e.g. none
+// for JPY, three for BHD).
+func (a Amount) Format(locale string) string {
+	decimalSeparator := "."
+	if strings.HasPrefix(locale, "de") || strings.HasPrefix(locale, "fr") || strings.HasPrefix(locale, "es") {
+		decimalSeparator = ","
+	}
+
+	value := strconv.FormatFloat(math.Abs(a.Float64()), 'f', a.exponent, 64)
+	if decimalSeparator != "." {
+		value = strings.Replace(value, ".", decimalSeparator, 1)
+	}
+	if a.minorUnits < 0 {
+		value = "-" + value
+	}
+
+	if symbol, ok := currencySymbols[a.currency]; ok {
+		return symbol + value
+	}
+	if a.currency != "" {
+		return a.currency + " " + value
+	}
+	return value
+}
+
+var currencySymbols = map[string]string{
+	"USD": "$",
+	"CAD": "$",
+	"AUD": "$",
+	"GBP": "£",
+	"EUR": "€",
+	"JPY": "¥",
+}
+
+// MarshalJSON renders the amount as a plain JSON number with as many
+// decimal places as the currency's exponent calls for (two by default),
+// matching the wire format the ShopSavvy API sends.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatFloat(a.Float64(), 'f', a.exponent, 64)), nil
+}
+
+// UnmarshalJSON accepts both numeric (12.99) and string ("12.99") JSON
+// forms, since the API uses both depending on the endpoint. It has no way
+// to know the currency from the price field alone, so it decodes at the
+// default two-decimal exponent; callers that also have the sibling
+// currency field (e.g. Offer) should call SetCurrency afterward to rescale
+// correctly for currencies like JPY or BHD.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if s == "null" {
+		*a = Amount{}
+		return nil
+	}
+	s = strings.Trim(s, `"`)
+
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("money: invalid amount %q: %w", string(data), err)
+	}
+	a.decoded = value
+	a.hasDecoded = true
+	a.exponent = defaultExponent
+	a.minorUnits = int64(math.Round(value * scale(a.exponent)))
+	return nil
+}
@@ -0,0 +1,128 @@
+package shopsavvy
+
+import (
+	"fmt"
+	"time"
+)
+
+// Format selects the verbosity of product data returned by an endpoint.
+type Format string
+
+const (
+	FormatFull    Format = "full"
+	FormatCompact Format = "compact"
+)
+
+func (f Format) validate() error {
+	switch f {
+	case "", FormatFull, FormatCompact:
+		return nil
+	default:
+		return fmt.Errorf("shopsavvy: unknown format %q", f)
+	}
+}
+
+// Retailer identifies one of the retailers ShopSavvy tracks offers from. An
+// empty Retailer means "all retailers".
+type Retailer string
+
+const (
+	RetailerAmazon    Retailer = "amazon"
+	RetailerWalmart   Retailer = "walmart"
+	RetailerTarget    Retailer = "target"
+	RetailerBestBuy   Retailer = "bestbuy"
+	RetailerCostco    Retailer = "costco"
+	RetailerHomeDepot Retailer = "homedepot"
+	RetailerEbay      Retailer = "ebay"
+)
+
+func (r Retailer) validate() error {
+	switch r {
+	case "", RetailerAmazon, RetailerWalmart, RetailerTarget, RetailerBestBuy, RetailerCostco, RetailerHomeDepot, RetailerEbay:
+		return nil
+	default:
+		return fmt.Errorf("shopsavvy: unknown retailer %q", r)
+	}
+}
+
+// SearchQuery describes a product search. Query is required; Limit, Offset,
+// Format and Retailer are optional.
+type SearchQuery struct {
+	Query    string
+	Limit    int
+	Offset   int
+	Format   Format
+	Retailer Retailer
+}
+
+func (q SearchQuery) validate() error {
+	if q.Query == "" {
+		return fmt.Errorf("shopsavvy: SearchQuery.Query is required")
+	}
+	if err := q.Format.validate(); err != nil {
+		return err
+	}
+	return q.Retailer.validate()
+}
+
+func (q SearchQuery) buildParams() map[string]string {
+	params := map[string]string{"q": q.Query}
+	if q.Limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", q.Limit)
+	}
+	if q.Offset > 0 {
+		params["offset"] = fmt.Sprintf("%d", q.Offset)
+	}
+	if q.Format != "" {
+		params["format"] = string(q.Format)
+	}
+	if q.Retailer != "" {
+		params["retailer"] = string(q.Retailer)
+	}
+	return params
+}
+
+// PriceHistoryQuery describes a price history lookup. Identifier, StartDate
+// and EndDate are required; Retailer and Format are optional. StartDate and
+// EndDate are sent to the API as RFC3339 timestamps.
+type PriceHistoryQuery struct {
+	Identifier string
+	StartDate  time.Time
+	EndDate    time.Time
+	Retailer   Retailer
+	Format     Format
+}
+
+func (q PriceHistoryQuery) validate() error {
+	if q.Identifier == "" {
+		return fmt.Errorf("shopsavvy: PriceHistoryQuery.Identifier is required")
+	}
+	if q.StartDate.IsZero() {
+		return fmt.Errorf("shopsavvy: PriceHistoryQuery.StartDate is required")
+	}
+	if q.EndDate.IsZero() {
+		return fmt.Errorf("shopsavvy: PriceHistoryQuery.EndDate is required")
+	}
+	if q.EndDate.Before(q.StartDate) {
+		return fmt.Errorf("shopsavvy: PriceHistoryQuery.EndDate is before StartDate")
+	}
+	if err := q.Format.validate(); err != nil {
+		return err
+	}
+	return q.Retailer.validate()
+}
+
+func (q PriceHistoryQuery) buildParams() map[string]string {
+	params := map[string]string{
+		"ids":        q.Identifier,
+		"start_date": q.StartDate.Format(time.RFC3339),
+		"end_date":   q.EndDate.Format(time.RFC3339),
+	}
+	if q.Retailer != "" {
+		params["retailer"] = string(q.Retailer)
+	}
+	if q.Format != "" {
+		params["format"] = string(q.Format)
+	}
+	return params
+}
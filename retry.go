@@ -0,0 +1,146 @@
+package shopsavvy
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// idempotencyHeader is set on requests that have explicitly opted in to
+// retries on otherwise non-idempotent verbs (POST/DELETE).
+const idempotencyHeader = "Idempotency-Key"
+
+// RequestOption customizes a single request, as opposed to Option which
+// configures the Client as a whole.
+type RequestOption func(*resty.Request)
+
+// WithIdempotencyKey marks a single POST/DELETE call as safe to retry by
+// attaching an Idempotency-Key header. Without it, WithRetry only retries
+// GET requests.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(r *resty.Request) {
+		r.SetHeader(idempotencyHeader, key)
+	}
+}
+
+func applyRequestOptions(r *resty.Request, opts []RequestOption) {
+	for _, opt := range opts {
+		opt(r)
+	}
+}
+
+// isRetryable decides whether a response/error pair should trigger a retry.
+// Only idempotent requests (GET, or any request carrying an Idempotency-Key)
+// are retried, and only on network errors, 5xx responses, or 429s.
+func isRetryable(resp *resty.Response, err error) bool {
+	if resp == nil || resp.Request == nil {
+		return err != nil
+	}
+
+	idempotent := resp.Request.Method == http.MethodGet || resp.Request.Header.Get(idempotencyHeader) != ""
+	if !idempotent {
+		return false
+	}
+
+	// Error responses also arrive with err set (the client's OnAfterResponse
+	// hook turns them into typed errors), so only a request that got no
+	// response at all counts as a network error.
+	if resp.RawResponse == nil {
+		return err != nil
+	}
+
+	status := resp.StatusCode()
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// retryAfter computes the wait before the next retry attempt. For 429
+// responses it honors the Retry-After header (delta-seconds or HTTP-date);
+// otherwise it falls back to jittered exponential backoff seeded by
+// Config.RetryBaseDelay.
+func (c *Client) retryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+	attempt := 1
+	if resp != nil && resp.Request != nil && resp.Request.Attempt > 0 {
+		attempt = resp.Request.Attempt
+	}
+
+	if resp != nil && resp.StatusCode() == http.StatusTooManyRequests {
+		if d, ok := parseRetryAfter(resp.Header().Get("Retry-After")); ok {
+			return d, nil
+		}
+	}
+
+	backoff := c.config.RetryBaseDelay * time.Duration(uint64(1)<<uint(attempt-1))
+	jitter := time.Duration(rand.Int63n(int64(backoff) + 1))
+	return backoff/2 + jitter/2, nil
+}
+
+// parseRetryAfter supports both the delta-seconds and HTTP-date forms of the
+// Retry-After header.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// rateLimitProbe extracts just enough of a response body to observe the
+// remaining rate-limit budget without disturbing the caller's typed result.
+type rateLimitProbe struct {
+	Meta *APIMeta `json:"meta"`
+}
+
+// recordRateLimitRemaining is an OnAfterResponse hook that tracks the most
+// recently observed APIMeta.RateLimitRemaining for throttleForRateLimit.
+func (c *Client) recordRateLimitRemaining(_ *resty.Client, resp *resty.Response) error {
+	var probe rateLimitProbe
+	if err := json.Unmarshal(resp.Body(), &probe); err != nil {
+		return nil
+	}
+	if probe.Meta != nil && probe.Meta.RateLimitRemaining != nil {
+		atomic.StoreInt32(&c.rateLimitRemaining, int32(*probe.Meta.RateLimitRemaining))
+		atomic.StoreInt32(&c.rateLimitKnown, 1)
+	}
+	return nil
+}
+
+// throttleForRateLimit is an OnBeforeRequest hook that proactively delays
+// requests once the remaining budget drops below Config.RateLimitThreshold,
+// spreading load instead of racing straight into a 429.
+func (c *Client) throttleForRateLimit(_ *resty.Client, req *resty.Request) error {
+	if atomic.LoadInt32(&c.rateLimitKnown) == 0 {
+		return nil
+	}
+
+	remaining := int(atomic.LoadInt32(&c.rateLimitRemaining))
+	threshold := c.config.RateLimitThreshold
+	if threshold <= 0 || remaining >= threshold {
+		return nil
+	}
+
+	deficit := threshold - remaining
+	delay := c.config.RetryBaseDelay * time.Duration(deficit)
+	if delay <= 0 {
+		delay = time.Duration(deficit) * 100 * time.Millisecond
+	}
+
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
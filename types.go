@@ -1,5 +1,11 @@
 package shopsavvy
 
+import (
+	"encoding/json"
+
+	"github.com/shopsavvy/sdk-go/money"
+)
+
 // APIMeta contains credit usage info from the API response
 type APIMeta struct {
 	CreditsUsed        int  `json:"credits_used"`
@@ -9,10 +15,11 @@ type APIMeta struct {
 
 // APIResponse represents a response from the ShopSavvy API
 type APIResponse[T any] struct {
-	Success bool    `json:"success"`
-	Data    T       `json:"data"`
-	Message string  `json:"message,omitempty"`
-	Meta    *APIMeta `json:"meta,omitempty"`
+	Success    bool            `json:"success"`
+	Data       T               `json:"data"`
+	Message    string          `json:"message,omitempty"`
+	Meta       *APIMeta        `json:"meta,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
 }
 
 // CreditsUsed returns the credits used from the meta object
@@ -72,7 +79,7 @@ func (p *ProductDetails) ImageURL() *string {
 type Offer struct {
 	ID           string              `json:"id"`
 	Retailer     *string             `json:"retailer,omitempty"`
-	Price        *float64            `json:"price,omitempty"`
+	Price        *money.Amount       `json:"price,omitempty"`
 	Currency     *string             `json:"currency,omitempty"`
 	Availability *string             `json:"availability,omitempty"`
 	Condition    *string             `json:"condition,omitempty"`
@@ -82,6 +89,39 @@ type Offer struct {
 	History      []PriceHistoryEntry `json:"history,omitempty"`
 }
 
+// offerAlias has the same fields as Offer; decoding into it instead of
+// Offer directly avoids infinite recursion through Offer's UnmarshalJSON.
+type offerAlias Offer
+
+// UnmarshalJSON decodes an Offer and then copies Currency onto Price (and
+// each entry of History), since the API reports them as sibling fields and
+// Amount itself only knows how to decode the numeric value.
+func (o *Offer) UnmarshalJSON(data []byte) error {
+	var alias offerAlias
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+	*o = Offer(alias)
+	if o.Currency != nil {
+		if o.Price != nil {
+			o.Price.SetCurrency(*o.Currency)
+		}
+		for i := range o.History {
+			o.History[i].Price.SetCurrency(*o.Currency)
+		}
+	}
+	return nil
+}
+
+// PriceFloat returns the price as a float64 (deprecated, use Price
+// directly for exact arithmetic).
+func (o *Offer) PriceFloat() float64 {
+	if o.Price == nil {
+		return 0
+	}
+	return o.Price.Float64()
+}
+
 // OfferID returns the offer ID (deprecated alias)
 func (o *Offer) OfferID() string {
 	return o.ID
@@ -105,9 +145,15 @@ type ProductWithOffers struct {
 
 // PriceHistoryEntry represents a single price point in history
 type PriceHistoryEntry struct {
-	Date         string  `json:"date"`
-	Price        float64 `json:"price"`
-	Availability string  `json:"availability"`
+	Date         string       `json:"date"`
+	Price        money.Amount `json:"price"`
+	Availability string       `json:"availability"`
+}
+
+// PriceFloat returns the price as a float64 (deprecated, use Price directly
+// for exact arithmetic).
+func (p *PriceHistoryEntry) PriceFloat() float64 {
+	return p.Price.Float64()
 }
 
 // OfferWithHistory represents an offer with price history
@@ -116,6 +162,32 @@ type OfferWithHistory struct {
 	PriceHistory []PriceHistoryEntry `json:"price_history"`
 }
 
+// UnmarshalJSON decodes OfferWithHistory explicitly rather than relying on
+// the embedded Offer.UnmarshalJSON being promoted, since Go would otherwise
+// use that promoted method for the whole struct and silently drop
+// PriceHistory. It also propagates Offer.Currency onto each PriceHistory
+// entry's Price, the same way Offer does for its own Price/History.
+func (o *OfferWithHistory) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &o.Offer); err != nil {
+		return err
+	}
+
+	var wrapper struct {
+		PriceHistory []PriceHistoryEntry `json:"price_history"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	o.PriceHistory = wrapper.PriceHistory
+
+	if o.Currency != nil {
+		for i := range o.PriceHistory {
+			o.PriceHistory[i].Price.SetCurrency(*o.Currency)
+		}
+	}
+	return nil
+}
+
 // ScheduledProduct represents a product that is scheduled for monitoring
 type ScheduledProduct struct {
 	ProductID     string  `json:"product_id"`
@@ -227,5 +299,14 @@ type RemoveBatchResponse struct {
 
 // APIErrorResponse represents an error response from the API
 type APIErrorResponse struct {
-	Error string `json:"error"`
+	Error  string            `json:"error"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// WebhookRegistration represents a registered webhook endpoint.
+type WebhookRegistration struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	CreatedAt string   `json:"created_at"`
 }
@@ -0,0 +1,46 @@
+package webhooks
+
+import (
+	"time"
+
+	shopsavvy "github.com/shopsavvy/sdk-go"
+	"github.com/shopsavvy/sdk-go/money"
+)
+
+// EventType identifies the kind of payload a webhook delivery carries.
+type EventType string
+
+const (
+	EventPriceChange         EventType = "price_change"
+	EventAvailabilityChange  EventType = "availability_change"
+	EventMonitoringRefreshed EventType = "monitoring_refreshed"
+)
+
+// PriceChangeEvent is delivered when a monitored offer's price changes.
+type PriceChangeEvent struct {
+	DeliveryID    string          `json:"delivery_id"`
+	ProductID     string          `json:"product_id"`
+	Identifier    string          `json:"identifier"`
+	Offer         shopsavvy.Offer `json:"offer"`
+	PreviousPrice *money.Amount   `json:"previous_price,omitempty"`
+	Timestamp     time.Time       `json:"timestamp"`
+}
+
+// AvailabilityChangeEvent is delivered when a monitored offer's availability
+// changes (e.g. in stock to out of stock).
+type AvailabilityChangeEvent struct {
+	DeliveryID           string          `json:"delivery_id"`
+	ProductID            string          `json:"product_id"`
+	Identifier           string          `json:"identifier"`
+	Offer                shopsavvy.Offer `json:"offer"`
+	PreviousAvailability string          `json:"previous_availability"`
+	Timestamp            time.Time       `json:"timestamp"`
+}
+
+// MonitoringRefreshedEvent is delivered when a scheduled product's data has
+// been re-fetched, whether or not the price or availability changed.
+type MonitoringRefreshedEvent struct {
+	DeliveryID string                     `json:"delivery_id"`
+	Product    shopsavvy.ScheduledProduct `json:"product"`
+	Timestamp  time.Time                  `json:"timestamp"`
+}
@@ -0,0 +1,260 @@
+// Package webhooks implements a receiver for the HTTP callbacks ShopSavvy
+// sends when a product scheduled via Client.ScheduleProductMonitoring
+// changes price, changes availability, or is simply refreshed.
+//
+// Example usage:
+//
+//	h := webhooks.NewHandler(os.Getenv("SHOPSAVVY_WEBHOOK_SECRET"))
+//	h.OnPriceChange(func(ctx context.Context, e *webhooks.PriceChangeEvent) error {
+//		log.Printf("price changed for %s", e.Identifier)
+//		return nil
+//	})
+//	http.Handle("/webhooks/shopsavvy", h)
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSignatureHeader = "X-ShopSavvy-Signature"
+	defaultTimestampHeader = "X-ShopSavvy-Timestamp"
+	defaultMaxSkew         = 5 * time.Minute
+	defaultReplayTTL       = 10 * time.Minute
+)
+
+// envelope is the outer shape of every webhook delivery; Data is decoded
+// into the typed event once Event identifies which one applies.
+type envelope struct {
+	Event      EventType       `json:"event"`
+	DeliveryID string          `json:"delivery_id"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Handler verifies and dispatches ShopSavvy webhook deliveries. It
+// implements http.Handler and can be mounted directly on a ServeMux.
+type Handler struct {
+	secret          []byte
+	signatureHeader string
+	timestampHeader string
+	maxSkew         time.Duration
+	replayTTL       time.Duration
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+
+	onPriceChange         func(ctx context.Context, e *PriceChangeEvent) error
+	onAvailabilityChange  func(ctx context.Context, e *AvailabilityChangeEvent) error
+	onMonitoringRefreshed func(ctx context.Context, e *MonitoringRefreshedEvent) error
+}
+
+// HandlerOption customizes a Handler created by NewHandler.
+type HandlerOption func(*Handler)
+
+// WithSignatureHeader overrides the header ShopSavvy signs deliveries with.
+// Defaults to X-ShopSavvy-Signature.
+func WithSignatureHeader(name string) HandlerOption {
+	return func(h *Handler) { h.signatureHeader = name }
+}
+
+// WithTimestampHeader overrides the header carrying the delivery's unix
+// timestamp. Defaults to X-ShopSavvy-Timestamp.
+func WithTimestampHeader(name string) HandlerOption {
+	return func(h *Handler) { h.timestampHeader = name }
+}
+
+// WithMaxSkew sets how stale a delivery's timestamp may be before it's
+// rejected. Defaults to 5 minutes.
+func WithMaxSkew(d time.Duration) HandlerOption {
+	return func(h *Handler) { h.maxSkew = d }
+}
+
+// WithReplayTTL sets how long a delivery-id is remembered for replay
+// protection. Defaults to 10 minutes.
+func WithReplayTTL(d time.Duration) HandlerOption {
+	return func(h *Handler) { h.replayTTL = d }
+}
+
+// NewHandler creates a Handler that verifies deliveries using the given
+// shared secret.
+func NewHandler(secret string, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		secret:          []byte(secret),
+		signatureHeader: defaultSignatureHeader,
+		timestampHeader: defaultTimestampHeader,
+		maxSkew:         defaultMaxSkew,
+		replayTTL:       defaultReplayTTL,
+		seen:            make(map[string]time.Time),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// OnPriceChange registers a callback invoked for price_change deliveries.
+func (h *Handler) OnPriceChange(fn func(ctx context.Context, e *PriceChangeEvent) error) {
+	h.onPriceChange = fn
+}
+
+// OnAvailabilityChange registers a callback invoked for availability_change
+// deliveries.
+func (h *Handler) OnAvailabilityChange(fn func(ctx context.Context, e *AvailabilityChangeEvent) error) {
+	h.onAvailabilityChange = fn
+}
+
+// OnMonitoringRefreshed registers a callback invoked for
+// monitoring_refreshed deliveries.
+func (h *Handler) OnMonitoringRefreshed(fn func(ctx context.Context, e *MonitoringRefreshedEvent) error) {
+	h.onMonitoringRefreshed = fn
+}
+
+// ServeHTTP verifies the delivery's signature and timestamp, decodes its
+// payload, and dispatches it to the matching registered callback.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "unable to read body", http.StatusBadRequest)
+		return
+	}
+
+	timestamp := r.Header.Get(h.timestampHeader)
+	if !h.verifySignature(r.Header.Get(h.signatureHeader), timestamp, body) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	if !h.verifyTimestamp(timestamp) {
+		http.Error(w, "stale delivery", http.StatusBadRequest)
+		return
+	}
+
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		http.Error(w, "malformed payload", http.StatusBadRequest)
+		return
+	}
+
+	if h.isReplay(env.DeliveryID) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), env); err != nil {
+		// Unclaim the delivery-id so a transient failure doesn't permanently
+		// swallow the event: ShopSavvy's retry of the same delivery-id
+		// within replayTTL must still reach dispatch.
+		h.forget(env.DeliveryID)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// verifySignature checks the HMAC over "timestamp.body" rather than the
+// body alone, so the timestamp can't be rewritten on a captured delivery to
+// slip past verifyTimestamp once its delivery-id ages out of the replay
+// cache.
+func (h *Handler) verifySignature(signature, timestamp string, body []byte) bool {
+	if signature == "" || timestamp == "" {
+		return false
+	}
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+func (h *Handler) verifyTimestamp(header string) bool {
+	if header == "" {
+		return false
+	}
+	sec, err := strconv.ParseInt(header, 10, 64)
+	if err != nil {
+		return false
+	}
+	delivered := time.Unix(sec, 0)
+	skew := time.Since(delivered)
+	if skew < 0 {
+		skew = -skew
+	}
+	return skew <= h.maxSkew
+}
+
+// isReplay reports whether deliveryID has already been processed within
+// replayTTL, sweeping expired entries as it goes so the cache stays bounded.
+func (h *Handler) isReplay(deliveryID string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	for id, seenAt := range h.seen {
+		if now.Sub(seenAt) > h.replayTTL {
+			delete(h.seen, id)
+		}
+	}
+
+	if _, ok := h.seen[deliveryID]; ok {
+		return true
+	}
+	h.seen[deliveryID] = now
+	return false
+}
+
+// forget removes deliveryID from the replay cache, used to unclaim a
+// delivery whose dispatch failed so a retry of the same delivery-id isn't
+// mistaken for a replay.
+func (h *Handler) forget(deliveryID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.seen, deliveryID)
+}
+
+func (h *Handler) dispatch(ctx context.Context, env envelope) error {
+	switch env.Event {
+	case EventPriceChange:
+		if h.onPriceChange == nil {
+			return nil
+		}
+		var e PriceChangeEvent
+		if err := json.Unmarshal(env.Data, &e); err != nil {
+			return err
+		}
+		e.DeliveryID = env.DeliveryID
+		return h.onPriceChange(ctx, &e)
+	case EventAvailabilityChange:
+		if h.onAvailabilityChange == nil {
+			return nil
+		}
+		var e AvailabilityChangeEvent
+		if err := json.Unmarshal(env.Data, &e); err != nil {
+			return err
+		}
+		e.DeliveryID = env.DeliveryID
+		return h.onAvailabilityChange(ctx, &e)
+	case EventMonitoringRefreshed:
+		if h.onMonitoringRefreshed == nil {
+			return nil
+		}
+		var e MonitoringRefreshedEvent
+		if err := json.Unmarshal(env.Data, &e); err != nil {
+			return err
+		}
+		e.DeliveryID = env.DeliveryID
+		return h.onMonitoringRefreshed(ctx, &e)
+	default:
+		return nil
+	}
+}